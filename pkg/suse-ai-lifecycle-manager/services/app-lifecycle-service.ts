@@ -7,6 +7,8 @@
 
 import { log as logger } from '../utils/logger';
 import { createErrorHandler, handleSimpleError } from '../utils/error-handler';
+import { HELM_ACTION_DEFAULTS } from '../utils/constants';
+import { helmTimeout, releaseDescription, assertInstallNamespaceAllowed, existingReleaseError, type HelmActionOptions } from '../utils/helm-actions';
 import type {
   RancherStore,
   AppCRD,
@@ -63,8 +65,8 @@ export class AppLifecycleService {
    * Create or upgrade an application in a Rancher-managed cluster
    *
    * This method handles both installation and upgrade scenarios:
-   * - If the app doesn't exist, it will be installed
-   * - If the app exists, it will be upgraded
+   * - 'install' installs the app and refuses if a release with that name already exists
+   * - 'upgrade' upgrades the existing release
    * - Uses Rancher's cluster repository API for operations
    *
    * @param $store - Rancher store instance for API calls
@@ -73,7 +75,8 @@ export class AppLifecycleService {
    * @param releaseName - Unique name for the Helm release
    * @param chart - Chart reference including repository, chart name, and version
    * @param values - Helm values to customize the installation
-   * @param preferredAction - Preferred action if the operation is ambiguous
+   * @param preferredAction - Whether to install a new release or upgrade an existing one
+   * @param options - Optional Helm settings for the action
    * @throws Error if installation/upgrade fails or cluster is unreachable
    *
    * @example
@@ -95,7 +98,8 @@ export class AppLifecycleService {
     releaseName: string,
     chart: ChartRef,
     values: Record<string, unknown>,
-    preferredAction: 'install' | 'upgrade' = 'install'
+    preferredAction: 'install' | 'upgrade' = 'install',
+    options: HelmActionOptions = {}
   ): Promise<void> {
 
     const errorHandler = createErrorHandler($store, 'AppLifecycleService');
@@ -112,6 +116,10 @@ export class AppLifecycleService {
       }
     });
 
+    if (preferredAction === 'install') {
+      assertInstallNamespaceAllowed(namespace);
+    }
+
     const clusterReposUrl = `/k8s/clusters/${encodeURIComponent(clusterId)}/v1/catalog.cattle.io.clusterrepos/${chart.repoName}?action=${preferredAction}`;
     const appsUrl = `/k8s/clusters/${encodeURIComponent(clusterId)}/apis/catalog.cattle.io/v1/namespaces/${encodeURIComponent(namespace)}/apps`;
     const appUrl = `${appsUrl}/${encodeURIComponent(releaseName)}`;
@@ -127,6 +135,7 @@ export class AppLifecycleService {
           chartName: chart.chartName,
           version: chart.version,
           releaseName,
+          description: releaseDescription(preferredAction, releaseName),
           annotations: {
             'catalog.cattle.io/ui-source-repo-type': 'cluster',
             'catalog.cattle.io/ui-source-repo': chart.repoName
@@ -135,28 +144,6 @@ export class AppLifecycleService {
         }
       ];
 
-      const appPayload = {
-        apiVersion: 'catalog.cattle.io/v1',
-        kind: 'App',
-        metadata: {
-          namespace,
-          name: releaseName,
-          labels: { 'catalog.cattle.io/cluster-repo-name': chart.repoName },
-          resourceVersion: undefined as string | undefined
-        },
-        spec: {
-          chart: {
-            metadata: {
-              name: chart.chartName,
-              version: chart.version,
-            }
-          },
-          name: releaseName,
-          namespace: namespace,
-          values,
-        },
-      };
-
       // For upgrade actions, use the clusterRepo action directly
       if (preferredAction === 'upgrade') {
         logger.info('Performing upgrade via clusterRepo action', {
@@ -168,8 +155,9 @@ export class AppLifecycleService {
           charts,
           namespace,
           clusterId,
-          wait: true,
-          timeout: '600s',
+          wait: options.wait ?? HELM_ACTION_DEFAULTS.WAIT,
+          timeout: helmTimeout(options.waitTimeout ?? HELM_ACTION_DEFAULTS.WAIT_TIMEOUT),
+          historyMax: options.historyMax ?? HELM_ACTION_DEFAULTS.HISTORY_MAX,
           noHooks: false,
           disableOpenAPIValidation: false,
           skipCRDs: false
@@ -195,79 +183,60 @@ export class AppLifecycleService {
         }
       }
 
-      // For install actions, check if app exists first and use PUT if it does
+      let existing: any = null;
       try {
         logger.debug('Checking for existing app', {
           component: 'AppLifecycleService',
           data: { namespace, releaseName }
         });
 
-        const existing = await $store.dispatch('rancher/request', { url: appUrl, timeout: 20000 });
-        const resourceVersion = existing?.data?.metadata?.resourceVersion || existing?.metadata?.resourceVersion;
-
-        if (resourceVersion) {
-          logger.info('App exists, performing upgrade', {
-            component: 'AppLifecycleService',
-            data: { releaseName, resourceVersion }
-          });
-
-          appPayload.metadata.resourceVersion = resourceVersion;
-          await $store.dispatch('rancher/request', {
-            url: appUrl,
-            method: 'PUT',
-            data: appPayload,
-            timeout: 20000
-          });
-
-          logger.info('App upgrade successful', {
-            component: 'AppLifecycleService',
-            data: { releaseName }
-          });
-        } else {
-          throw new Error('App exists but could not retrieve resourceVersion.');
-        }
+        existing = await $store.dispatch('rancher/request', { url: appUrl, timeout: 20000 });
       } catch (e: unknown) {
         const standardError = errorHandler.normalizeError(e);
 
-        if (standardError.status === 404) {
-          logger.info('App does not exist, performing install', {
-            component: 'AppLifecycleService',
-            data: { releaseName }
-          });
-
-          const installData = {
-            charts,
-            namespace,
-            clusterId,
-            wait: true,
-            timeout: '600s',
-            noHooks: false,
-            disableOpenAPIValidation: false,
-            skipCRDs: false
-          };
-
-          try {
-            await $store.dispatch('rancher/request', {
-              method: 'post',
-              url: clusterReposUrl,
-              data: installData,
-              timeout: 20000
-            });
-
-            logger.info('App install successful', {
-              component: 'AppLifecycleService',
-              data: { releaseName }
-            });
-          } catch (installError: unknown) {
-            const installStandardError = errorHandler.handleApiError(installError, 'install', { releaseName, namespace });
-            throw new Error(`Failed to install app: ${installStandardError.message}`);
-          }
-        } else {
+        if (standardError.status !== 404) {
           // For non-404 errors during app check, handle and re-throw
           errorHandler.handleApiError(e, 'check-app', { releaseName, namespace, status: standardError.status });
           throw e; // Re-throw original error to be caught by outer handler
         }
       }
+
+      if (existing) {
+        throw existingReleaseError(existing, releaseName, namespace);
+      }
+
+      logger.info('App does not exist, performing install', {
+        component: 'AppLifecycleService',
+        data: { releaseName }
+      });
+
+      const installData = {
+        charts,
+        namespace,
+        clusterId,
+        wait: options.wait ?? HELM_ACTION_DEFAULTS.WAIT,
+        timeout: helmTimeout(options.waitTimeout ?? HELM_ACTION_DEFAULTS.WAIT_TIMEOUT),
+        noHooks: false,
+        disableOpenAPIValidation: false,
+        skipCRDs: false
+      };
+
+      try {
+        await $store.dispatch('rancher/request', {
+          method: 'post',
+          url: clusterReposUrl,
+          data: installData,
+          timeout: 20000
+        });
+
+        logger.info('App install successful', {
+          component: 'AppLifecycleService',
+          data: { releaseName }
+        });
+      } catch (installError: unknown) {
+        const installStandardError = errorHandler.handleApiError(installError, 'install', { releaseName, namespace });
+        throw new Error(`Failed to install app: ${installStandardError.message}`);
+      }
     } catch (projectError: unknown) {
       // Only handle if this is a new error, not a re-thrown error from inner catch
       if (projectError instanceof Error && projectError.message.includes('Failed to')) {
@@ -2,6 +2,8 @@ import yaml from 'js-yaml';
 import { log as logger } from '../utils/logger';
 import { createChartValuesService } from './chart-values';
 import { getClusterContext } from '../utils/cluster-operations';
+import { retry, isRetryableError } from '../utils/promise';
+import { INDEX_FETCH_RETRY, INDEX_FETCH_TIMEOUT } from '../utils/constants';
 import type {
   RancherStore,
   ClusterResource,
@@ -104,7 +106,22 @@ export class ChartService {
     if (!indexLink) return null;
 
     try {
-      const res = await $store.dispatch('rancher/request', { url: indexLink, timeout: 20000 });
+      const res = await retry(
+        () => $store.dispatch('rancher/request', { url: indexLink, timeout: INDEX_FETCH_TIMEOUT }),
+        {
+          maxAttempts:    INDEX_FETCH_RETRY.MAX_ATTEMPTS,
+          baseDelay:      INDEX_FETCH_RETRY.BASE_DELAY,
+          maxDelay:       INDEX_FETCH_RETRY.MAX_DELAY,
+          jitter:         true,
+          timeout:        0,
+          deadline:       INDEX_FETCH_RETRY.DEADLINE,
+          retryCondition: isRetryableError,
+          onRetry:        (attempt, err) => logger.debug('Repository index fetch failed, retrying', {
+            component: 'ChartService',
+            data: { repoName, attempt, err }
+          })
+        }
+      );
       const payload = (res?.data ?? res);
 
       logger.debug('Repository index fetched', {
@@ -42,6 +42,9 @@ import type {
   isRancherError
 } from '../types/rancher-types';
 import { getClusterContext } from '../utils/cluster-operations';
+import { INDEX_FETCH_RETRY, INDEX_FETCH_TIMEOUT, HELM_ACTION_DEFAULTS, TIMEOUT_VALUES } from '../utils/constants';
+import { retry, isRetryableError } from '../utils/promise';
+import { helmTimeout, releaseDescription, assertInstallNamespaceAllowed, existingReleaseError, type HelmActionOptions } from '../utils/helm-actions';
 
 export interface ChartRef {
   repoName: string;   // ClusterRepo metadata.name
@@ -120,7 +123,8 @@ export async function createOrUpgradeApp(
   releaseName: string,
   chart: { repoName: string; chartName: string; version: string },
   values: Record<string, unknown>,
-  preferredAction: 'install' | 'upgrade' = 'install'
+  preferredAction: 'install' | 'upgrade' = 'install',
+  options: HelmActionOptions = {}
 ) {
   const errorHandler = createErrorHandler($store, 'RancherApps');
   const log = (l: string, ...a: unknown[]) => { try { console.log(`[SUSE-AI-INSTALL] ${l}`, ...a); } catch {} };
@@ -136,6 +140,10 @@ export async function createOrUpgradeApp(
     valuesSize: JSON.stringify(values || {}).length 
   });
 
+  if (preferredAction === 'install') {
+    assertInstallNamespaceAllowed(namespace);
+  }
+
   const clusterReposUrl = `/k8s/clusters/${encodeURIComponent(clusterId)}/v1/catalog.cattle.io.clusterrepos/${chart.repoName}?action=${preferredAction}`;
   const appsUrl = `/k8s/clusters/${encodeURIComponent(clusterId)}/apis/catalog.cattle.io/v1/namespaces/${encodeURIComponent(namespace)}/apps`;
   const appUrl = `${appsUrl}/${encodeURIComponent(releaseName)}`;
@@ -149,6 +157,7 @@ export async function createOrUpgradeApp(
         chartName: chart.chartName,
         version: chart.version,
         releaseName,
+        description: releaseDescription(preferredAction, releaseName),
         annotations: {
           'catalog.cattle.io/ui-source-repo-type': 'cluster',
           'catalog.cattle.io/ui-source-repo': chart.repoName
@@ -158,28 +167,6 @@ export async function createOrUpgradeApp(
     ];
     log('Charts array prepared:', charts);
 
-    const appPayload = {
-      apiVersion: 'catalog.cattle.io/v1',
-      kind:       'App',
-      metadata:   {
-        namespace,
-        name:   releaseName,
-        labels: { 'catalog.cattle.io/cluster-repo-name': chart.repoName },
-        resourceVersion: undefined as string | undefined
-      },
-      spec: {
-        chart: {
-          metadata: {
-            name:    chart.chartName,
-            version: chart.version,
-          }
-        },
-        name:      releaseName,
-        namespace: namespace,
-        values,
-      },
-    };
-
     // For upgrade actions, use the clusterRepo action directly instead of trying PUT
     if (preferredAction === 'upgrade') {
       log('Performing upgrade via clusterRepo action');
@@ -187,8 +174,9 @@ export async function createOrUpgradeApp(
         charts,
         namespace,
         clusterId,
-        wait: true,
-        timeout: '600s',
+        wait: options.wait ?? HELM_ACTION_DEFAULTS.WAIT,
+        timeout: helmTimeout(options.waitTimeout ?? HELM_ACTION_DEFAULTS.WAIT_TIMEOUT),
+        historyMax: options.historyMax ?? HELM_ACTION_DEFAULTS.HISTORY_MAX,
         noHooks: false,
         disableOpenAPIValidation: false,
         skipCRDs: false
@@ -211,71 +199,55 @@ export async function createOrUpgradeApp(
       }
     }
 
-    // For install actions, check if app exists first and use PUT if it does
+    let existing: any = null;
     try {
       log('Checking for existing App...', { namespace, releaseName, checkUrl: appUrl });
-      const existing = await $store.dispatch('rancher/request', { url: appUrl, timeout: 20000 });
-      // Extract resourceVersion for app update
-      const resourceVersion = existing?.data?.metadata?.resourceVersion
-                           || existing?.metadata?.resourceVersion;
-
-      if (resourceVersion) {
-        log('App exists, performing upgrade (PUT)', { resourceVersion });
-        appPayload.metadata.resourceVersion = resourceVersion;
-        const upgradeResult = await $store.dispatch('rancher/request', {
-          url:    appUrl,
-          method: 'PUT',
-          data:   appPayload,
-          timeout: 20000
-        });
-        log('App upgrade successful. Result:', upgradeResult);
-      } else {
-        log('ERROR: App exists but could not retrieve resourceVersion from any path');
-        log('Full existing object structure:', JSON.stringify(existing, null, 2));
-        throw new Error('App exists but could not retrieve resourceVersion.');
-      }
+      existing = await $store.dispatch('rancher/request', { url: appUrl, timeout: 20000 });
     } catch (e: unknown) {
       const standardError = errorHandler.normalizeError(e);
 
-      log('Exception during app check/upgrade:', {
-        error: e,
-        status: standardError.status,
-        message: standardError.message,
-        details: standardError.details
-      });
-
-      if (standardError.status === 404) {
-        log('App does not exist (404), performing install (POST)');
-        
-        const installData = {
-          charts,
-          namespace,
-          clusterId,
-          wait: true,
-          timeout: '600s',
-          noHooks: false,
-          disableOpenAPIValidation: false,
-          skipCRDs: false
-        };
-        
-        try {
-          const installResult = await $store.dispatch('rancher/request', {
-            method: 'post',
-            url: clusterReposUrl,
-            data: installData,
-            timeout: 20000
-          });
-          log('App install successful');
-        } catch (installError: unknown) {
-          const standardError = errorHandler.handleApiError(installError, 'install', { releaseName, namespace });
-          throw new Error(`Failed to install app: ${standardError.message}`);
-        }
-      } else {
+      if (standardError.status !== 404) {
+        log('Exception during app check:', {
+          error: e,
+          status: standardError.status,
+          message: standardError.message,
+          details: standardError.details
+        });
         // For non-404 errors during app check, handle and re-throw
         errorHandler.handleApiError(e, 'check-app', { releaseName, namespace, status: standardError.status });
         throw e; // Re-throw original error to be caught by outer handler
       }
     }
+
+    if (existing) {
+      throw existingReleaseError(existing, releaseName, namespace);
+    }
+
+    log('App does not exist (404), performing install (POST)');
+
+    const installData = {
+      charts,
+      namespace,
+      clusterId,
+      wait: options.wait ?? HELM_ACTION_DEFAULTS.WAIT,
+      timeout: helmTimeout(options.waitTimeout ?? HELM_ACTION_DEFAULTS.WAIT_TIMEOUT),
+      noHooks: false,
+      disableOpenAPIValidation: false,
+      skipCRDs: false
+    };
+
+    try {
+      await $store.dispatch('rancher/request', {
+        method: 'post',
+        url: clusterReposUrl,
+        data: installData,
+        timeout: 20000
+      });
+      log('App install successful');
+    } catch (installError: unknown) {
+      const standardError = errorHandler.handleApiError(installError, 'install', { releaseName, namespace });
+      throw new Error(`Failed to install app: ${standardError.message}`);
+    }
   } catch (projectError: unknown) {
     // Only handle if this is a new error, not a re-thrown error from inner catch
     if (projectError instanceof Error && projectError.message.includes('Failed to')) {
@@ -333,6 +305,59 @@ export async function waitForAppInstall(
   }
 }
 
+// Helm only reports the release as deployed once its workloads are ready, provided the action ran with
+// wait enabled. With wait: false, deployed just means the chart was applied, so callers must not use this then.
+export async function waitForAppDeployed(
+  $store: RancherStore,
+  clusterId: string,
+  namespace: string,
+  releaseName: string,
+  timeoutMs: number = TIMEOUT_VALUES.INSTALL
+): Promise<AppCRD> {
+  const errorHandler = createErrorHandler($store, 'RancherApps');
+  const url = `/k8s/clusters/${encodeURIComponent(clusterId)}/apis/catalog.cattle.io/v1/namespaces/${encodeURIComponent(namespace)}/apps/${encodeURIComponent(releaseName)}`;
+  const start = Date.now();
+  const pause = () => new Promise(r => setTimeout(r, 3000));
+  let state = 'unknown';
+  let lastErr: unknown = null;
+
+  log('post-install: wait for workloads to become ready', { clusterId, namespace, releaseName, timeoutMs });
+
+  for (;;) {
+    if (Date.now() - start > timeoutMs) {
+      const msg = `Workloads for ${releaseName} did not become ready in time (last state: ${state})`;
+      throw new Error(lastErr ? `${msg}; last error: ${handleSimpleError(lastErr)}` : msg);
+    }
+
+    let app: any;
+    try {
+      const r = await $store.dispatch('rancher/request', { url, timeout: 20000 });
+      app = r?.data ?? r;
+      lastErr = null;
+    } catch (e: unknown) {
+      lastErr = e;
+      // keep polling on 404, network failures (no status) and transient errors; others (e.g. 403) won't resolve by waiting
+      const standardError = errorHandler.normalizeError(e);
+      if (standardError.status && standardError.status !== 404 && !isRetryableError(e)) {
+        throw new Error(`Failed to read status of ${releaseName}: ${handleSimpleError(e)}`);
+      }
+      await pause();
+      continue;
+    }
+
+    const sum = app?.status?.summary || {};
+    state = sum?.state || state;
+
+    log('post-install: release state', { state, ns: namespace, name: releaseName });
+    if (state === 'deployed') return app;
+    if (state === 'failed' || sum?.error) {
+      throw new Error(`Release ${releaseName} failed to deploy${sum?.message ? `: ${sum.message}` : ''}`);
+    }
+
+    await pause();
+  }
+}
+
 export async function deleteApp($store: RancherStore, clusterId: string, namespace: string, releaseName: string, repoName?: string): Promise<void> {
   try {
     const url =
@@ -522,7 +547,19 @@ async function getRepoIndex($store: RancherStore, repoName: string): Promise<Rep
   const indexLink = await getRepoIndexLink($store, repoName);
   if (!indexLink) return null;
 
-  const res = await $store.dispatch('rancher/request', { url: indexLink, timeout: 20000 });
+  const res = await retry(
+    () => $store.dispatch('rancher/request', { url: indexLink, timeout: INDEX_FETCH_TIMEOUT }),
+    {
+      maxAttempts:    INDEX_FETCH_RETRY.MAX_ATTEMPTS,
+      baseDelay:      INDEX_FETCH_RETRY.BASE_DELAY,
+      maxDelay:       INDEX_FETCH_RETRY.MAX_DELAY,
+      jitter:         true,
+      timeout:        0,
+      deadline:       INDEX_FETCH_RETRY.DEADLINE,
+      retryCondition: isRetryableError,
+      onRetry:        (attempt, err) => log('repo index fetch failed, retrying', { repoName, attempt, err })
+    }
+  );
   const payload = (res?.data ?? res);
   dbg('index payload', payload);
   if (typeof payload === 'string') return yaml.load(payload);
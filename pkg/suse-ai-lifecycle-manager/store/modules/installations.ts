@@ -357,7 +357,8 @@ const actions = {
           version: payload.chartVersion || 'latest'
         },
         payload.values || {},
-        'install'
+        'install',
+        payload.helmOptions
       );
       
       commit('UPDATE_OPERATION', {
@@ -437,7 +438,8 @@ const actions = {
           version: payload.chartVersion || 'latest'
         },
         payload.values || {},
-        'upgrade'
+        'upgrade',
+        payload.helmOptions
       );
       
       commit('UPDATE_OPERATION', {
@@ -6,6 +6,7 @@
 import { AppSummary, AppInstallationInfo } from '../../types/app-types';
 import { ClusterResourceData } from '../../models/cluster/cluster-resource';
 import { RepositoryResourceData } from '../../models/cluster/repository-resource';
+import type { HelmActionOptions } from '../../utils/helm-actions';
 
 // === Discovery Progress States ===
 export interface DiscoveryProgress {
@@ -248,6 +249,7 @@ export interface InstallAppPayload {
   releaseName: string;
   values?: Record<string, any>;
   chartVersion?: string;
+  helmOptions?: HelmActionOptions;
 }
 
 export interface UpgradeAppPayload {
@@ -258,6 +260,7 @@ export interface UpgradeAppPayload {
   values?: Record<string, any>;
   chartVersion?: string;
   resetValues?: boolean;
+  helmOptions?: HelmActionOptions;
 }
 
 export interface UninstallAppPayload {
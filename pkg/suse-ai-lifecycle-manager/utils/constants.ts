@@ -175,6 +175,19 @@ export const RETRY_CONFIG = {
   BACKOFF_FACTOR: 2
 } as const;
 
+// Per-request timeout for index fetches; large indexes can take a while to download
+export const INDEX_FETCH_TIMEOUT = 30000;
+
+// Retries for Helm repository index fetches, which go through flaky repo servers
+export const INDEX_FETCH_RETRY = {
+  MAX_ATTEMPTS: 4,
+  BASE_DELAY: 500,
+  MAX_DELAY: 8000,
+  // Overall budget tied to the request timeout: fast 5xx/429 failures get all attempts,
+  // but a request that ran into the timeout is not retried
+  DEADLINE: INDEX_FETCH_TIMEOUT
+} as const;
+
 // === Default Values ===
 export const DEFAULT_VALUES = {
   NAMESPACE: 'default',
@@ -185,6 +198,13 @@ export const DEFAULT_VALUES = {
   MAX_CONCURRENT_OPERATIONS: 3
 } as const;
 
+// Namespaces the extension refuses to install into
+export const PROTECTED_NAMESPACES: readonly string[] = [
+  'kube-system',
+  'kube-public',
+  'cattle-system'
+];
+
 // === API and Service Constants ===
 export const API_ENDPOINTS = {
   APPS: '/api/apps',
@@ -204,6 +224,13 @@ export const HELM_CONSTANTS = {
   PART_OF_LABEL: 'app.kubernetes.io/part-of'
 } as const;
 
+// Defaults for Helm options passed to Rancher's install/upgrade actions
+export const HELM_ACTION_DEFAULTS = {
+  HISTORY_MAX: 10,
+  WAIT: true,
+  WAIT_TIMEOUT: TIMEOUT_VALUES.INSTALL
+} as const;
+
 // === Feature Flags (will be used by feature-flags.ts) ===
 export const FEATURE_FLAGS = {
   BULK_OPERATIONS: 'bulk-operations',
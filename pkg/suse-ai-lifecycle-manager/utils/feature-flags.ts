@@ -189,19 +189,56 @@ export const RELEASE_FEATURES: Record<FeatureFlag, FeatureConfig> = {
 };
 
 // === Version Comparison Utilities ===
+
+// Split "v1.2.3-rc.1+build" into numeric core parts and pre-release identifiers (build metadata is ignored)
+function parseVersionParts(version: string): { core: number[]; pre: string[] } {
+  const main = version.trim().replace(/^v/, '').split('+')[0];
+  const dash = main.indexOf('-');
+  const core = dash === -1 ? main : main.slice(0, dash);
+  const pre = dash === -1 ? '' : main.slice(dash + 1);
+
+  return {
+    core: core.split('.').map(n => parseInt(n, 10) || 0),
+    pre:  pre ? pre.split('.') : []
+  };
+}
+
 export function compareVersions(version1: string, version2: string): number {
-  const v1Parts = version1.split('.').map(Number);
-  const v2Parts = version2.split('.').map(Number);
+  const v1 = parseVersionParts(version1);
+  const v2 = parseVersionParts(version2);
   
-  const maxLength = Math.max(v1Parts.length, v2Parts.length);
+  const maxLength = Math.max(v1.core.length, v2.core.length);
   
   for (let i = 0; i < maxLength; i++) {
-    const v1Part = v1Parts[i] || 0;
-    const v2Part = v2Parts[i] || 0;
+    const v1Part = v1.core[i] || 0;
+    const v2Part = v2.core[i] || 0;
     
     if (v1Part > v2Part) return 1;
     if (v1Part < v2Part) return -1;
   }
+
+  // Same core version: a pre-release sorts before the release itself (1.2.0-rc.1 < 1.2.0)
+  if (!v1.pre.length || !v2.pre.length) {
+    return v1.pre.length === v2.pre.length ? 0 : (v1.pre.length ? -1 : 1);
+  }
+
+  for (let i = 0; i < Math.max(v1.pre.length, v2.pre.length); i++) {
+    const a = v1.pre[i];
+    const b = v2.pre[i];
+
+    if (a === undefined) return -1;
+    if (b === undefined) return 1;
+    if (a === b) continue;
+
+    const aNum = /^\d+$/.test(a);
+    const bNum = /^\d+$/.test(b);
+
+    // Numeric identifiers compare numerically and sort before alphanumeric ones
+    if (aNum && bNum) return parseInt(a, 10) > parseInt(b, 10) ? 1 : -1;
+    if (aNum !== bNum) return aNum ? -1 : 1;
+
+    return a > b ? 1 : -1;
+  }
   
   return 0;
 }
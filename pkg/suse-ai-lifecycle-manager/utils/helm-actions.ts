@@ -0,0 +1,41 @@
+/**
+ * Helm Action Utilities
+ * Shared settings and guards for installs/upgrades through Rancher's clusterrepo actions
+ */
+
+import { PRODUCT_NAME, EXTENSION_VERSION, PROTECTED_NAMESPACES } from './constants';
+
+export interface HelmActionOptions {
+  historyMax?: number;  // release revisions kept on upgrade
+  wait?: boolean;       // wait for workloads to be ready before the action completes
+  waitTimeout?: number; // Helm timeout in milliseconds
+}
+
+// Helm timeouts are durations such as "600s"
+export function helmTimeout(ms: number): string {
+  return `${Math.ceil(ms / 1000)}s`;
+}
+
+// Helm release description shown in `helm history`, so revisions made from the extension can be told apart
+export function releaseDescription(action: 'install' | 'upgrade', releaseName: string): string {
+  const verb = action === 'upgrade' ? 'Upgraded' : 'Installed';
+
+  return `${verb} by ${PRODUCT_NAME} Lifecycle Manager v${EXTENSION_VERSION} for ${releaseName}`;
+}
+
+// Enforced by the install services rather than the UI so every install path is covered before any Helm operation
+export function assertInstallNamespaceAllowed(namespace: string): void {
+  const trimmed = namespace.trim();
+
+  if (PROTECTED_NAMESPACES.includes(trimmed)) {
+    throw new Error(`Failed to install app: namespace "${trimmed}" is protected. Choose a different namespace.`);
+  }
+}
+
+// Installs never take over an existing release; changing one goes through the upgrade action
+export function existingReleaseError(existing: any, releaseName: string, namespace: string): Error {
+  const existingChart = existing?.data?.spec?.chart?.metadata?.name || existing?.spec?.chart?.metadata?.name;
+  const chartInfo = existingChart ? ` (chart "${existingChart}")` : '';
+
+  return new Error(`Failed to install app: release "${releaseName}" already exists in namespace "${namespace}"${chartInfo}. Use Manage to upgrade it.`);
+}
@@ -12,7 +12,9 @@ export interface RetryOptions {
   baseDelay?: number;
   maxDelay?: number;
   backoffFactor?: number;
+  jitter?: boolean;
   timeout?: number;
+  deadline?: number; // Overall time budget in ms across all attempts (0 = none)
   retryCondition?: (error: any) => boolean;
   onRetry?: (attempt: number, error: any) => void;
 }
@@ -72,12 +74,15 @@ export async function retry<T>(
     baseDelay = RETRY_CONFIG.BASE_DELAY,
     maxDelay = RETRY_CONFIG.MAX_DELAY,
     backoffFactor = RETRY_CONFIG.BACKOFF_FACTOR,
+    jitter = false,
     timeout = TIMEOUT_VALUES.MEDIUM,
+    deadline = 0,
     retryCondition = () => true,
     onRetry
   } = options;
   
   let lastError: any;
+  const startTime = Date.now();
   
   // Wrap with timeout
   const fnWithTimeout = timeout > 0 ? withTimeout(fn, timeout) : fn;
@@ -98,10 +103,20 @@ export async function retry<T>(
       }
       
       // Calculate delay with exponential backoff
-      const delay = Math.min(
+      let delay = Math.min(
         baseDelay * Math.pow(backoffFactor, attempt - 1),
         maxDelay
       );
+
+      // Spread retries out so concurrent callers don't hit the server in lockstep
+      if (jitter) {
+        delay = Math.round(delay / 2 + Math.random() * delay / 2);
+      }
+
+      // Don't start another attempt once the overall budget is spent
+      if (deadline > 0 && Date.now() - startTime + delay >= deadline) {
+        throw error;
+      }
       
       // Call retry callback if provided
       if (onRetry) {
@@ -568,14 +583,18 @@ export function isRetryableError(error: any): boolean {
   if (error.code === 'ENOTFOUND' || error.code === 'ECONNREFUSED' || error.code === 'ETIMEDOUT') {
     return true;
   }
-  
+
+  // HTTP status: Rancher's request action uses _status, axios response.status, Kubernetes errors a numeric code
+  const status = [error._status, error.response?.status, error.status, error.code]
+    .find(s => typeof s === 'number');
+
   // HTTP 5xx errors are retryable
-  if (error.response?.status >= 500) {
+  if (status >= 500) {
     return true;
   }
   
-  // Rate limiting (429) is retryable
-  if (error.response?.status === 429) {
+  // Rate limiting (429) and server-side request timeouts (408) are retryable
+  if (status === 429 || status === 408) {
     return true;
   }
   
@@ -3,7 +3,7 @@
  */
 
 import type { ValidationResult, ValidationError, FieldValidationRule } from '../utils/validation';
-import { ERROR_CODES } from '../utils/constants';
+import { ERROR_CODES, PROTECTED_NAMESPACES } from '../utils/constants';
 
 export function validateReleaseName(name: string): ValidationResult {
   const errors: ValidationError[] = [];
@@ -31,12 +31,12 @@ export function validateReleaseName(name: string): ValidationResult {
     });
   }
 
-  // DNS-1123 subdomain validation (Helm requirement)
-  const dnsRegex = /^[a-z0-9]([-a-z0-9]*[a-z0-9])?$/;
+  // DNS-1123 subdomain validation (Helm requirement; dotted names such as my.app are allowed)
+  const dnsRegex = /^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$/;
   if (!dnsRegex.test(trimmedName)) {
     errors.push({
       field: 'releaseName',
-      message: 'Release name must be a valid DNS-1123 subdomain (lowercase letters, numbers, and hyphens only)',
+      message: 'Release name must be a valid DNS-1123 subdomain (lowercase letters, numbers, hyphens and dots)',
       code: ERROR_CODES.INVALID_FORMAT,
       severity: 'error'
     });
@@ -97,8 +97,7 @@ export function validateNamespace(namespace: string): ValidationResult {
   }
 
   // Reserved namespace check
-  const reservedNamespaces = ['kube-system', 'kube-public', 'kube-node-lease', 'default'];
-  if (reservedNamespaces.includes(trimmedNamespace)) {
+  if ([...PROTECTED_NAMESPACES, 'kube-node-lease'].includes(trimmedNamespace)) {
     errors.push({
       field: 'namespace',
       message: `Cannot use reserved namespace: ${trimmedNamespace}`,
@@ -107,10 +106,20 @@ export function validateNamespace(namespace: string): ValidationResult {
     });
   }
 
+  // The default namespace works, but apps are easier to manage in a dedicated one
+  const warnings: ValidationWarning[] = [];
+  if (trimmedNamespace === 'default') {
+    warnings.push({
+      field: 'namespace',
+      message: 'Installing into the default namespace is not recommended',
+      suggestion: 'Use a dedicated namespace for the application'
+    });
+  }
+
   return {
     valid: errors.length === 0,
     errors,
-    warnings: []
+    warnings
   };
 }
 